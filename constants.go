@@ -0,0 +1,11 @@
+package restful
+
+// Copyright 2013 Ernest Micklei. All rights reserved.
+// Use of this source code is governed by a license
+// that can be found in the LICENSE file.
+
+const (
+    HEADER_Allow       = "Allow"
+    HEADER_Accept      = "Accept"
+    HEADER_ContentType = "Content-Type"
+)
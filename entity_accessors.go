@@ -0,0 +1,152 @@
+package restful
+
+// Copyright 2013 Ernest Micklei. All rights reserved.
+// Use of this source code is governed by a license
+// that can be found in the LICENSE file.
+
+import (
+    "encoding/json"
+    "encoding/xml"
+    "sort"
+    "strconv"
+    "strings"
+)
+
+// EntityReaderWriter can read and write values using an encoding such as
+// JSON, XML etc. Requests accept an EntityReaderWriter according to the
+// Content-Type of the request; Responses pick one according to a
+// content-negotiated match against the Accept header.
+type EntityReaderWriter interface {
+    // Read a serialized version of the value from the request.
+    Read(req *Request, v interface{}) error
+
+    // Write a serialized version of the value on the response.
+    Write(resp *Response, status int, v interface{}) error
+}
+
+// entityAccessRegistry maps a MIME type to its EntityReaderWriter and keeps
+// track of the order in which types were registered so Accept negotiation
+// has a deterministic fallback.
+type entityAccessRegistry struct {
+    accessors map[string]EntityReaderWriter
+    mimeTypes []string
+}
+
+var entityAccessors *entityAccessRegistry
+
+func init() {
+    entityAccessors = &entityAccessRegistry{accessors: map[string]EntityReaderWriter{}}
+    RegisterEntityAccessor(MIME_JSON, entityJSONAccess{})
+    RegisterEntityAccessor(MIME_XML, entityXMLAccess{})
+}
+
+// RegisterEntityAccessor adds or replaces the EntityReaderWriter for the
+// given MIME type, e.g. "application/x-protobuf". Built-in accessors for
+// JSON and XML are registered by default and can be overridden, for example
+// to plug in a zero-allocation JSON decoder.
+func RegisterEntityAccessor(mime string, rw EntityReaderWriter) {
+    if _, exists := entityAccessors.accessors[mime]; !exists {
+        entityAccessors.mimeTypes = append(entityAccessors.mimeTypes, mime)
+    }
+    entityAccessors.accessors[mime] = rw
+}
+
+// accessorAt returns the EntityReaderWriter registered for mime, or false if
+// none was registered.
+func (r *entityAccessRegistry) accessorAt(mime string) (EntityReaderWriter, bool) {
+    rw, ok := r.accessors[mime]
+    return rw, ok
+}
+
+// accessorForContentType picks the EntityReaderWriter whose MIME type is
+// contained in contentType, e.g. "application/json; charset=utf-8".
+func (r *entityAccessRegistry) accessorForContentType(contentType string) (EntityReaderWriter, bool) {
+    for _, mime := range r.mimeTypes {
+        if strings.Contains(contentType, mime) {
+            return r.accessors[mime], true
+        }
+    }
+    return nil, false
+}
+
+// accessorForAccept performs q-value aware negotiation over the Accept
+// header and returns the best matching registered EntityReaderWriter.
+func (r *entityAccessRegistry) accessorForAccept(accept string) (EntityReaderWriter, string, bool) {
+    for _, candidate := range parseAccept(accept) {
+        if candidate.mime == "*/*" {
+            if len(r.mimeTypes) > 0 {
+                return r.accessors[r.mimeTypes[0]], r.mimeTypes[0], true
+            }
+            continue
+        }
+        if rw, ok := r.accessors[candidate.mime]; ok {
+            return rw, candidate.mime, true
+        }
+    }
+    return nil, "", false
+}
+
+// acceptCandidate is one entry of a parsed Accept (or Content-Type) header.
+type acceptCandidate struct {
+    mime string
+    q    float64
+}
+
+// parseAccept parses a header value such as
+// "application/json;q=0.9, application/xml;q=0.8, */*;q=0.1" into a list of
+// candidates ordered from the highest to the lowest q-value.
+func parseAccept(header string) []acceptCandidate {
+    var candidates []acceptCandidate
+    for _, part := range strings.Split(header, ",") {
+        part = strings.TrimSpace(part)
+        if part == "" {
+            continue
+        }
+        segments := strings.Split(part, ";")
+        mime := strings.TrimSpace(segments[0])
+        q := 1.0
+        for _, param := range segments[1:] {
+            param = strings.TrimSpace(param)
+            if strings.HasPrefix(param, "q=") {
+                if parsed, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err == nil {
+                    q = parsed
+                }
+            }
+        }
+        candidates = append(candidates, acceptCandidate{mime: mime, q: q})
+    }
+    sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+    return candidates
+}
+
+// entityJSONAccess is the default EntityReaderWriter for application/json.
+type entityJSONAccess struct{}
+
+func (e entityJSONAccess) Read(req *Request, v interface{}) error {
+    decoder := json.NewDecoder(req.Request.Body)
+    decoder.UseNumber()
+    return decoder.Decode(v)
+}
+
+func (e entityJSONAccess) Write(resp *Response, status int, v interface{}) error {
+    return writeJSON(resp, status, MIME_JSON, v)
+}
+
+// entityXMLAccess is the default EntityReaderWriter for application/xml.
+type entityXMLAccess struct{}
+
+func (e entityXMLAccess) Read(req *Request, v interface{}) error {
+    return xml.NewDecoder(req.Request.Body).Decode(v)
+}
+
+func (e entityXMLAccess) Write(resp *Response, status int, v interface{}) error {
+    resp.Header().Set(HEADER_ContentType, MIME_XML)
+    resp.WriteHeader(status)
+    return xml.NewEncoder(resp).Encode(v)
+}
+
+func writeJSON(resp *Response, status int, contentType string, v interface{}) error {
+    resp.Header().Set(HEADER_ContentType, contentType)
+    resp.WriteHeader(status)
+    return json.NewEncoder(resp).Encode(v)
+}
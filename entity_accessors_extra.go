@@ -0,0 +1,113 @@
+package restful
+
+// Copyright 2013 Ernest Micklei. All rights reserved.
+// Use of this source code is governed by a license
+// that can be found in the LICENSE file.
+
+import (
+    "io/ioutil"
+
+    "github.com/fxamacker/cbor/v2"
+    "github.com/vmihailenco/msgpack/v5"
+    "google.golang.org/protobuf/proto"
+    "gopkg.in/yaml.v2"
+)
+
+// MIME_PROTOBUF is the Content-Type / Accept value for Protocol Buffers.
+const MIME_PROTOBUF = "application/x-protobuf"
+
+// MIME_MSGPACK is the Content-Type / Accept value for MessagePack.
+const MIME_MSGPACK = "application/msgpack"
+
+// MIME_YAML is the Content-Type / Accept value for YAML.
+const MIME_YAML = "application/yaml"
+
+// MIME_CBOR is the Content-Type / Accept value for CBOR.
+const MIME_CBOR = "application/cbor"
+
+func init() {
+    RegisterEntityAccessor(MIME_PROTOBUF, entityProtobufAccess{})
+    RegisterEntityAccessor(MIME_MSGPACK, entityMsgpackAccess{})
+    RegisterEntityAccessor(MIME_YAML, entityYAMLAccess{})
+    RegisterEntityAccessor(MIME_CBOR, entityCBORAccess{})
+}
+
+// entityProtobufAccess is the built-in EntityReaderWriter for
+// application/x-protobuf. v must implement proto.Message.
+type entityProtobufAccess struct{}
+
+func (e entityProtobufAccess) Read(req *Request, v interface{}) error {
+    data, err := ioutil.ReadAll(req.Request.Body)
+    if err != nil {
+        return err
+    }
+    msg, ok := v.(proto.Message)
+    if !ok {
+        return NewError(500, "protobuf accessor requires a proto.Message")
+    }
+    return proto.Unmarshal(data, msg)
+}
+
+func (e entityProtobufAccess) Write(resp *Response, status int, v interface{}) error {
+    msg, ok := v.(proto.Message)
+    if !ok {
+        return NewError(500, "protobuf accessor requires a proto.Message")
+    }
+    data, err := proto.Marshal(msg)
+    if err != nil {
+        return err
+    }
+    resp.Header().Set(HEADER_ContentType, MIME_PROTOBUF)
+    resp.WriteHeader(status)
+    _, err = resp.Write(data)
+    return err
+}
+
+// entityMsgpackAccess is the built-in EntityReaderWriter for
+// application/msgpack.
+type entityMsgpackAccess struct{}
+
+func (e entityMsgpackAccess) Read(req *Request, v interface{}) error {
+    return msgpack.NewDecoder(req.Request.Body).Decode(v)
+}
+
+func (e entityMsgpackAccess) Write(resp *Response, status int, v interface{}) error {
+    resp.Header().Set(HEADER_ContentType, MIME_MSGPACK)
+    resp.WriteHeader(status)
+    return msgpack.NewEncoder(resp).Encode(v)
+}
+
+// entityYAMLAccess is the built-in EntityReaderWriter for application/yaml.
+type entityYAMLAccess struct{}
+
+func (e entityYAMLAccess) Read(req *Request, v interface{}) error {
+    data, err := ioutil.ReadAll(req.Request.Body)
+    if err != nil {
+        return err
+    }
+    return yaml.Unmarshal(data, v)
+}
+
+func (e entityYAMLAccess) Write(resp *Response, status int, v interface{}) error {
+    data, err := yaml.Marshal(v)
+    if err != nil {
+        return err
+    }
+    resp.Header().Set(HEADER_ContentType, MIME_YAML)
+    resp.WriteHeader(status)
+    _, err = resp.Write(data)
+    return err
+}
+
+// entityCBORAccess is the built-in EntityReaderWriter for application/cbor.
+type entityCBORAccess struct{}
+
+func (e entityCBORAccess) Read(req *Request, v interface{}) error {
+    return cbor.NewDecoder(req.Request.Body).Decode(v)
+}
+
+func (e entityCBORAccess) Write(resp *Response, status int, v interface{}) error {
+    resp.Header().Set(HEADER_ContentType, MIME_CBOR)
+    resp.WriteHeader(status)
+    return cbor.NewEncoder(resp).Encode(v)
+}
@@ -0,0 +1,112 @@
+package restful
+
+import (
+    "net/http/httptest"
+    "testing"
+)
+
+func TestParseAccept_ordersByQValue(t *testing.T) {
+    candidates := parseAccept("application/json;q=0.5, application/xml;q=0.9, */*;q=0.1")
+    if len(candidates) != 3 {
+        t.Fatalf("expected 3 candidates, got %d", len(candidates))
+    }
+    if candidates[0].mime != "application/xml" {
+        t.Errorf("expected application/xml first, got %q", candidates[0].mime)
+    }
+    if candidates[1].mime != "application/json" {
+        t.Errorf("expected application/json second, got %q", candidates[1].mime)
+    }
+    if candidates[2].mime != "*/*" {
+        t.Errorf("expected */* last, got %q", candidates[2].mime)
+    }
+}
+
+func TestParseAccept_defaultsQTo1(t *testing.T) {
+    candidates := parseAccept("application/xml, application/json;q=0.8")
+    if len(candidates) != 2 || candidates[0].mime != "application/xml" {
+        t.Fatalf("expected application/xml (q=1) to sort first, got %v", candidates)
+    }
+}
+
+func TestAccessorForAccept_picksHighestQMatch(t *testing.T) {
+    rw, mime, ok := entityAccessors.accessorForAccept("application/xml;q=0.3, application/json;q=0.8")
+    if !ok {
+        t.Fatal("expected a match")
+    }
+    if mime != MIME_JSON {
+        t.Errorf("expected %s to win on q-value, got %q", MIME_JSON, mime)
+    }
+    if _, ok := rw.(entityJSONAccess); !ok {
+        t.Errorf("expected the JSON accessor, got %T", rw)
+    }
+}
+
+func TestAccessorForAccept_wildcardFallsBackToFirstRegistered(t *testing.T) {
+    rw, mime, ok := entityAccessors.accessorForAccept("*/*")
+    if !ok {
+        t.Fatal("expected a match for */*")
+    }
+    if mime != entityAccessors.mimeTypes[0] {
+        t.Errorf("expected the first registered mime %q, got %q", entityAccessors.mimeTypes[0], mime)
+    }
+    _ = rw
+}
+
+func TestAccessorForAccept_noMatch(t *testing.T) {
+    if _, _, ok := entityAccessors.accessorForAccept("application/x-unregistered"); ok {
+        t.Error("expected no match for an unregistered mime")
+    }
+}
+
+func TestAccessorForContentType_matchesPrefixedHeader(t *testing.T) {
+    rw, ok := entityAccessors.accessorForContentType("application/json; charset=utf-8")
+    if !ok {
+        t.Fatal("expected a match")
+    }
+    if _, ok := rw.(entityJSONAccess); !ok {
+        t.Errorf("expected the JSON accessor, got %T", rw)
+    }
+}
+
+func TestRegisterEntityAccessor_overridesExisting(t *testing.T) {
+    original, _ := entityAccessors.accessorAt(MIME_JSON)
+    defer RegisterEntityAccessor(MIME_JSON, original)
+
+    RegisterEntityAccessor(MIME_JSON, entityXMLAccess{})
+    rw, ok := entityAccessors.accessorAt(MIME_JSON)
+    if !ok {
+        t.Fatal("expected an accessor to still be registered")
+    }
+    if _, ok := rw.(entityXMLAccess); !ok {
+        t.Errorf("expected the overriding accessor, got %T", rw)
+    }
+}
+
+func TestResponseWriteEntity_negotiatesByAccept(t *testing.T) {
+    recorder := httptest.NewRecorder()
+    resp := NewResponse(recorder)
+    resp.requestAccept = MIME_XML
+
+    type greeting struct {
+        Message string `xml:"message"`
+    }
+    if err := resp.WriteEntity(200, greeting{Message: "hi"}); err != nil {
+        t.Fatalf("WriteEntity returned an error: %v", err)
+    }
+    if ct := recorder.Header().Get(HEADER_ContentType); ct != MIME_XML {
+        t.Errorf("expected Content-Type %s, got %q", MIME_XML, ct)
+    }
+}
+
+func TestResponseWriteEntity_fallsBackToJSONWhenAcceptUnmatched(t *testing.T) {
+    recorder := httptest.NewRecorder()
+    resp := NewResponse(recorder)
+    resp.requestAccept = "application/x-unregistered"
+
+    if err := resp.WriteEntity(200, map[string]string{"message": "hi"}); err != nil {
+        t.Fatalf("WriteEntity returned an error: %v", err)
+    }
+    if ct := recorder.Header().Get(HEADER_ContentType); ct != MIME_JSON {
+        t.Errorf("expected fallback Content-Type %s, got %q", MIME_JSON, ct)
+    }
+}
@@ -0,0 +1,22 @@
+package restful
+
+// Copyright 2013 Ernest Micklei. All rights reserved.
+// Use of this source code is governed by a license
+// that can be found in the LICENSE file.
+
+import "fmt"
+
+// ServiceError is a transport independent error to be used by services to fail with a known errorcode.
+type ServiceError struct {
+    Code    int
+    Message string
+}
+
+// NewError returns a ServiceError using the code and reason
+func NewError(code int, message string) ServiceError {
+    return ServiceError{Code: code, Message: message}
+}
+
+func (s ServiceError) Error() string {
+    return fmt.Sprintf("[ServiceError:%v] %v", s.Code, s.Message)
+}
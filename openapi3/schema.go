@@ -0,0 +1,29 @@
+package openapi3
+
+// Schema is a (subset of a) OpenAPI 3.0 Schema Object.
+// See https://spec.openapis.org/oas/v3.0.3#schema-object
+type Schema struct {
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+}
+
+// Components holds the reusable Schema objects collected while walking a
+// set of Go types, keyed by schema name as referenced from a Schema.Ref
+// of the form "#/components/schemas/<name>".
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas,omitempty"`
+}
+
+func newComponents() *Components {
+	return &Components{Schemas: map[string]*Schema{}}
+}
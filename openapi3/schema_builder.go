@@ -0,0 +1,211 @@
+package openapi3
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// SchemaBuilder walks reflected Go types and produces OpenAPI 3.0
+// Schema/Components objects. It is the OpenAPI 3.0 counterpart of
+// swagger.modelBuilder, which only targets Swagger 1.2.
+type SchemaBuilder struct {
+	Components *Components
+}
+
+// NewSchemaBuilder returns a SchemaBuilder with a freshly allocated
+// Components set.
+func NewSchemaBuilder() *SchemaBuilder {
+	return &SchemaBuilder{Components: newComponents()}
+}
+
+// SchemaFromSample builds (and registers in Components) the Schema for the
+// type of sample, returning a Schema that $ref's it when sample is a named
+// struct type.
+func (b *SchemaBuilder) SchemaFromSample(sample interface{}) *Schema {
+	return b.schemaFromType(reflect.TypeOf(sample))
+}
+
+func (b *SchemaBuilder) schemaFromType(st reflect.Type) *Schema {
+	for st.Kind() == reflect.Ptr {
+		st = st.Elem()
+	}
+	if st == reflect.TypeOf(time.Time{}) {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+	switch st.Kind() {
+	case reflect.Struct:
+		return b.refForStruct(st)
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: b.schemaFromType(st.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: b.schemaFromType(st.Elem())}
+	default:
+		if t, ok := jsonSchemaType(st.String()); ok {
+			return &Schema{Type: t, Format: jsonSchemaFormat(st.String())}
+		}
+		return &Schema{Type: "object"}
+	}
+}
+
+// refForStruct registers the struct's Schema in Components (if not already
+// present) and returns a Schema that references it by name.
+func (b *SchemaBuilder) refForStruct(st reflect.Type) *Schema {
+	name := st.Name()
+	if name == "" {
+		// anonymous struct: inline it rather than registering a ref
+		return b.buildStructSchema(st)
+	}
+	if _, ok := b.Components.Schemas[name]; !ok {
+		// reserve the name before recursing so recursive structs terminate
+		b.Components.Schemas[name] = &Schema{}
+		b.Components.Schemas[name] = b.buildStructSchema(st)
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+func (b *SchemaBuilder) buildStructSchema(st reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for i := 0; i < st.NumField(); i++ {
+		field := st.Field(i)
+		if field.Tag.Get("json") == "-" {
+			continue
+		}
+		if isEmbeddedStruct(field) {
+			// flatten an embedded struct's fields into this schema, the same
+			// way encoding/json promotes them, instead of nesting them under
+			// a property named after the embedded type.
+			embedded := b.buildStructSchema(field.Type)
+			for name, prop := range embedded.Properties {
+				schema.Properties[name] = prop
+			}
+			schema.Required = append(schema.Required, embedded.Required...)
+			continue
+		}
+		jsonName := jsonNameOfField(field)
+		if jsonName == "" {
+			continue
+		}
+		prop := b.schemaForField(field)
+		schema.Properties[jsonName] = prop
+		if isJSONRequired(field) {
+			schema.Required = append(schema.Required, jsonName)
+		}
+	}
+	return schema
+}
+
+func (b *SchemaBuilder) schemaForField(field reflect.StructField) *Schema {
+	fieldType := field.Type
+	var prop *Schema
+	if fieldType.Kind() == reflect.Ptr {
+		prop = b.schemaFromType(fieldType.Elem())
+		prop.Nullable = true
+	} else {
+		prop = b.schemaFromType(fieldType)
+	}
+
+	if desc := field.Tag.Get("description"); desc != "" {
+		prop.Description = desc
+	}
+	if enumTag := field.Tag.Get("enum"); enumTag != "" {
+		prop.Enum = strings.Split(enumTag, ",")
+	}
+	if oneofTag := field.Tag.Get("oneof"); oneofTag != "" {
+		prop.OneOf = b.schemasFromNames(strings.Split(oneofTag, ","))
+	}
+	if anyofTag := field.Tag.Get("anyof"); anyofTag != "" {
+		prop.AnyOf = b.schemasFromNames(strings.Split(anyofTag, ","))
+	}
+	return prop
+}
+
+// schemasFromNames resolves a list of type names (as registered elsewhere in
+// Components, e.g. via SchemaFromSample) into $ref Schemas for oneOf/anyOf.
+func (b *SchemaBuilder) schemasFromNames(names []string) []*Schema {
+	refs := make([]*Schema, 0, len(names))
+	for _, n := range names {
+		refs = append(refs, &Schema{Ref: "#/components/schemas/" + strings.TrimSpace(n)})
+	}
+	return refs
+}
+
+// isEmbeddedStruct reports whether field is an embedded struct (not time.Time,
+// which gets its own string/date-time Schema) without a json tag naming it,
+// the same condition swagger.modelBuilder uses to merge its fields instead
+// of nesting them.
+func isEmbeddedStruct(field reflect.StructField) bool {
+	return field.Anonymous &&
+		field.Type.Kind() == reflect.Struct &&
+		field.Type != reflect.TypeOf(time.Time{}) &&
+		!hasNamedJSONTag(field)
+}
+
+func hasNamedJSONTag(field reflect.StructField) bool {
+	parts := strings.Split(field.Tag.Get("json"), ",")
+	return len(parts[0]) > 0
+}
+
+func isJSONRequired(field reflect.StructField) bool {
+	jsonTag := field.Tag.Get("json")
+	if jsonTag == "" {
+		return true
+	}
+	parts := strings.Split(jsonTag, ",")
+	for _, p := range parts[1:] {
+		if p == "omitempty" {
+			return false
+		}
+	}
+	return field.Type.Kind() != reflect.Ptr
+}
+
+func jsonNameOfField(field reflect.StructField) string {
+	if jsonTag := field.Tag.Get("json"); jsonTag != "" {
+		name := strings.Split(jsonTag, ",")[0]
+		if name == "-" {
+			return ""
+		}
+		if name != "" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+// see also http://json-schema.org/latest/json-schema-core.html#anchor8
+func jsonSchemaType(goType string) (string, bool) {
+	schemaMap := map[string]string{
+		"uint8":  "integer",
+		"uint16": "integer",
+		"uint32": "integer",
+		"uint64": "integer",
+
+		"int":   "integer",
+		"int8":  "integer",
+		"int16": "integer",
+		"int32": "integer",
+		"int64": "integer",
+
+		"byte":    "integer",
+		"float64": "number",
+		"float32": "number",
+		"bool":    "boolean",
+		"string":  "string",
+	}
+	mapped, ok := schemaMap[goType]
+	return mapped, ok
+}
+
+func jsonSchemaFormat(goType string) string {
+	schemaMap := map[string]string{
+		"int":     "int32",
+		"int32":   "int32",
+		"int64":   "int64",
+		"byte":    "byte",
+		"uint8":   "byte",
+		"float64": "double",
+		"float32": "float",
+	}
+	return schemaMap[goType]
+}
@@ -0,0 +1,177 @@
+package openapi3
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testAddress struct {
+	City string `json:"city"`
+	Zip  string `json:"zip,omitempty"`
+}
+
+type testAuthor struct {
+	Name    string       `json:"name" description:"the author's full name"`
+	Tags    []string     `json:"tags,omitempty"`
+	Address *testAddress `json:"address,omitempty"`
+	Role    string       `json:"role" enum:"admin,editor,viewer"`
+}
+
+type testBase struct {
+	ID string `json:"id"`
+}
+
+type testChild struct {
+	testBase
+	Name string `json:"name"`
+}
+
+func TestSchemaFromSample_flattensEmbeddedStruct(t *testing.T) {
+	b := NewSchemaBuilder()
+	b.SchemaFromSample(testChild{})
+
+	registered, ok := b.Components.Schemas["testChild"]
+	if !ok {
+		t.Fatalf("expected testChild to be registered in Components")
+	}
+	if _, ok := registered.Properties["testBase"]; ok {
+		t.Error("expected the embedded struct to be flattened, not nested under its type name")
+	}
+	if _, ok := registered.Properties["id"]; !ok {
+		t.Fatalf("expected the embedded struct's 'id' property to be promoted, got %v", registered.Properties)
+	}
+	if _, ok := registered.Properties["name"]; !ok {
+		t.Errorf("expected the child's own 'name' property, got %v", registered.Properties)
+	}
+	wantRequired := map[string]bool{"id": true, "name": true}
+	if len(registered.Required) != len(wantRequired) {
+		t.Fatalf("expected %d required properties, got %v", len(wantRequired), registered.Required)
+	}
+	for _, r := range registered.Required {
+		if !wantRequired[r] {
+			t.Errorf("unexpected required property %q", r)
+		}
+	}
+}
+
+func TestSchemaFromSample_struct(t *testing.T) {
+	b := NewSchemaBuilder()
+	schema := b.SchemaFromSample(testAuthor{})
+
+	if schema.Ref != "#/components/schemas/testAuthor" {
+		t.Fatalf("expected a $ref to testAuthor, got %q", schema.Ref)
+	}
+	registered, ok := b.Components.Schemas["testAuthor"]
+	if !ok {
+		t.Fatalf("expected testAuthor to be registered in Components")
+	}
+	if registered.Type != "object" {
+		t.Errorf("expected type object, got %q", registered.Type)
+	}
+	nameProp, ok := registered.Properties["name"]
+	if !ok {
+		t.Fatalf("expected a 'name' property")
+	}
+	if nameProp.Description != "the author's full name" {
+		t.Errorf("expected the description tag to populate Description, got %q", nameProp.Description)
+	}
+	roleProp, ok := registered.Properties["role"]
+	if !ok {
+		t.Fatalf("expected a 'role' property")
+	}
+	if len(roleProp.Enum) != 3 || roleProp.Enum[0] != "admin" {
+		t.Errorf("expected enum tag to populate Enum, got %v", roleProp.Enum)
+	}
+}
+
+func TestSchemaFromSample_nestedAndNullable(t *testing.T) {
+	b := NewSchemaBuilder()
+	schema := b.SchemaFromSample(testAuthor{})
+	registered := b.Components.Schemas["testAuthor"]
+
+	addressProp, ok := registered.Properties["address"]
+	if !ok {
+		t.Fatalf("expected an 'address' property")
+	}
+	if !addressProp.Nullable {
+		t.Errorf("expected a pointer field to be nullable")
+	}
+	if addressProp.Ref != "#/components/schemas/testAddress" {
+		t.Errorf("expected the pointer field to $ref testAddress, got %q", addressProp.Ref)
+	}
+	if _, ok := b.Components.Schemas["testAddress"]; !ok {
+		t.Errorf("expected testAddress to be registered as a side effect of the address field")
+	}
+
+	tagsProp, ok := registered.Properties["tags"]
+	if !ok {
+		t.Fatalf("expected a 'tags' property")
+	}
+	if tagsProp.Type != "array" || tagsProp.Items == nil || tagsProp.Items.Type != "string" {
+		t.Errorf("expected tags to be an array of string, got %+v", tagsProp)
+	}
+
+	_ = schema
+}
+
+func TestSchemaFromSample_map(t *testing.T) {
+	type withMap struct {
+		Attributes map[string]string `json:"attributes"`
+	}
+	b := NewSchemaBuilder()
+	schema := b.SchemaFromSample(withMap{})
+	registered := b.Components.Schemas["withMap"]
+	attrProp := registered.Properties["attributes"]
+	if attrProp.Type != "object" || attrProp.AdditionalProperties == nil || attrProp.AdditionalProperties.Type != "string" {
+		t.Errorf("expected attributes to be an object with string additionalProperties, got %+v", attrProp)
+	}
+	_ = schema
+}
+
+func TestSchemaFromSample_oneof(t *testing.T) {
+	type either struct {
+		Value string `json:"value" oneof:"testAddress,testAuthor"`
+	}
+	b := NewSchemaBuilder()
+	b.SchemaFromSample(testAddress{})
+	b.SchemaFromSample(testAuthor{})
+	schema := b.SchemaFromSample(either{})
+	registered := b.Components.Schemas["either"]
+	valueProp := registered.Properties["value"]
+	if len(valueProp.OneOf) != 2 {
+		t.Fatalf("expected 2 oneOf entries, got %d", len(valueProp.OneOf))
+	}
+	if valueProp.OneOf[0].Ref != "#/components/schemas/testAddress" {
+		t.Errorf("expected the first oneOf entry to ref testAddress, got %q", valueProp.OneOf[0].Ref)
+	}
+	_ = schema
+}
+
+func TestSchemaFromSample_oneofKeepsSiblingTags(t *testing.T) {
+	type either struct {
+		Value string `json:"value" description:"one of two shapes" enum:"a,b" oneof:"testAddress,testAuthor"`
+	}
+	b := NewSchemaBuilder()
+	b.SchemaFromSample(testAddress{})
+	b.SchemaFromSample(testAuthor{})
+	b.SchemaFromSample(either{})
+	valueProp := b.Components.Schemas["either"].Properties["value"]
+	if valueProp.Description != "one of two shapes" {
+		t.Errorf("expected description to survive alongside oneof, got %q", valueProp.Description)
+	}
+	if len(valueProp.Enum) != 2 {
+		t.Errorf("expected enum to survive alongside oneof, got %v", valueProp.Enum)
+	}
+	if len(valueProp.OneOf) != 2 {
+		t.Errorf("expected 2 oneOf entries, got %d", len(valueProp.OneOf))
+	}
+}
+
+func TestSchemaFromType_timeTime(t *testing.T) {
+	b := NewSchemaBuilder()
+	schema := b.schemaFromType(reflect.TypeOf(time.Time{}))
+	if schema.Type != "string" || schema.Format != "date-time" {
+		t.Errorf("expected time.Time to map to type=string format=date-time, got %+v", schema)
+	}
+}
@@ -0,0 +1,26 @@
+package openapi3
+
+import (
+	"net/http"
+
+	restful "github.com/jabong/go-restful"
+)
+
+// Document is the root OpenAPI 3.0 document served at /openapi.json.
+type Document struct {
+	OpenAPI    string      `json:"openapi"`
+	Components *Components `json:"components,omitempty"`
+}
+
+// NewOpenAPI3Service builds a WebService that serves the OpenAPI 3.0
+// document generated from builder at /openapi.json.
+func NewOpenAPI3Service(builder *SchemaBuilder) *restful.WebService {
+	doc := Document{OpenAPI: "3.0.3", Components: builder.Components}
+
+	ws := new(restful.WebService)
+	ws.Path("/openapi.json")
+	ws.Route(ws.GET("").To(func(req *restful.Request, resp *restful.Response) {
+		resp.WriteEntity(http.StatusOK, doc)
+	}))
+	return ws
+}
@@ -7,7 +7,6 @@ package restful
 import (
     "bytes"
     "encoding/json"
-    "encoding/xml"
     "errors"
     "io"
     "io/ioutil"
@@ -106,33 +105,58 @@ func (r *Request) cachingReadEntity(contentType string, entityPointer interface{
     return r.decodeEntity(bytes.NewReader(buffer), contentType, entityPointer)
 }
 
-func (r *Request) decodeEntity(reader io.Reader, contentType string, entityPointer interface{}) (err error) {
-    if strings.Contains(contentType, MIME_XML) {
-        err := xml.NewDecoder(reader).Decode(entityPointer)
-        if err != nil {
-            return err
+// ReadEntityStream decodes directly from the request Body, bypassing the
+// bodyContent cache used by ReadEntity. Use this for large uploads where
+// buffering the whole body in memory is undesirable; unlike ReadEntity it
+// cannot be called more than once per request.
+func (r *Request) ReadEntityStream(entityPointer interface{}) (err error) {
+    contentType := r.Request.Header.Get(HEADER_ContentType)
+    return r.decodeEntity(r.Request.Body, contentType, entityPointer)
+}
+
+// DecodeJSONStream wraps a json.Decoder over the request Body and invokes fn
+// once per decoded json.Token, without buffering the body. It is intended
+// for incremental processing of large JSON arrays or NDJSON bulk payloads.
+func (r *Request) DecodeJSONStream(fn func(token json.Token) error) error {
+    decoder := json.NewDecoder(r.Request.Body)
+    for {
+        token, err := decoder.Token()
+        if err == io.EOF {
+            return nil
         }
-        return Validate(entityPointer)
-    }
-    if strings.Contains(contentType, MIME_JSON) || MIME_JSON == defaultRequestContentType {
-        decoder := json.NewDecoder(reader)
-        decoder.UseNumber()
-        err := decoder.Decode(entityPointer)
         if err != nil {
             return err
         }
-
-        return Validate(entityPointer)
-    }
-    if MIME_XML == defaultRequestContentType {
-        err := xml.NewDecoder(reader).Decode(entityPointer)
-        if err != nil {
+        if err := fn(token); err != nil {
             return err
         }
-        return Validate(entityPointer)
     }
+}
 
-    return NewError(400, "Unable to unmarshal content of type:"+contentType)
+func (r *Request) decodeEntity(reader io.Reader, contentType string, entityPointer interface{}) (err error) {
+    rw, ok := entityAccessors.accessorForContentType(contentType)
+    if !ok {
+        if defaultRequestContentType == "" {
+            return NewError(400, "Unable to unmarshal content of type:"+contentType)
+        }
+        rw, ok = entityAccessors.accessorAt(defaultRequestContentType)
+        if !ok {
+            return NewError(400, "Unable to unmarshal content of type:"+contentType)
+        }
+    }
+    httpRequest := *r.Request
+    httpRequest.Body = ioutil.NopCloser(reader)
+    if err := rw.Read(&Request{Request: &httpRequest}, entityPointer); err != nil {
+        return err
+    }
+    // ValidateStruct honours both the new `validate:"..."` tag vocabulary and
+    // the legacy `binding:"required"` tag, so every violation surfaces as a
+    // structured FieldError through validationErrorHandler instead of only
+    // the new tag getting that treatment.
+    if errs := ValidateStruct(entityPointer); len(errs) > 0 {
+        return validationErrorHandler(errs)
+    }
+    return nil
 }
 
 // SetAttribute adds or replaces the attribute with the given value.
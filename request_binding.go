@@ -0,0 +1,175 @@
+package restful
+
+// Copyright 2013 Ernest Micklei. All rights reserved.
+// Use of this source code is governed by a license
+// that can be found in the LICENSE file.
+
+import (
+    "errors"
+    "reflect"
+    "strconv"
+    "strings"
+    "time"
+)
+
+// BindAll populates target (a pointer to a struct) from the request's path
+// parameters, query parameters, headers and form values, using the `path`,
+// `query`, `header` and `form` struct tags to pick the source for each
+// field. A field tagged `json:"-"` is left untouched so callers can still
+// bind the request body onto it separately via ReadEntity. A `default`
+// tag supplies a fallback value when the source is absent, and the
+// `binding:"required"` and `validate` tags are honoured by ValidateStruct
+// after binding, the same way ReadEntity validates a decoded entity.
+//
+// Supported field types are string, the sized int/uint/float kinds, bool,
+// time.Time (parsed as RFC3339), slices of any of those (populated from a
+// comma-separated value or repeated query/form values), and pointers to any
+// of the above for "optional" semantics (left nil when the source is
+// absent).
+func (r *Request) BindAll(target interface{}) error {
+    val := reflect.ValueOf(target)
+    if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+        return errors.New("BindAll requires a pointer to a struct")
+    }
+    val = val.Elem()
+    typ := val.Type()
+
+    for i := 0; i < typ.NumField(); i++ {
+        field := typ.Field(i)
+        fieldValue := val.Field(i)
+        if !fieldValue.CanSet() {
+            continue
+        }
+        if field.Tag.Get("json") == "-" && !hasBindingSource(field) {
+            continue
+        }
+
+        raw, values, present := r.bindSource(field)
+        if !present {
+            if def := field.Tag.Get("default"); def != "" {
+                raw, values, present = def, []string{def}, true
+            }
+        }
+        if !present {
+            continue
+        }
+        if err := setFieldValue(fieldValue, raw, values); err != nil {
+            return err
+        }
+    }
+    if errs := ValidateStruct(target); len(errs) > 0 {
+        return validationErrorHandler(errs)
+    }
+    return nil
+}
+
+// hasBindingSource reports whether field carries one of the path/query/
+// header/form tags that BindAll understands.
+func hasBindingSource(field reflect.StructField) bool {
+    for _, tag := range []string{"path", "query", "header", "form"} {
+        if field.Tag.Get(tag) != "" {
+            return true
+        }
+    }
+    return false
+}
+
+// bindSource resolves the raw string (and, for repeated query/form values,
+// every value) for field from the request, using the first of the path,
+// query, header and form tags (in that order) that is set on the field; the
+// later tags are not consulted. present is false if none of those tags were
+// set or the named value was absent from the request.
+func (r *Request) bindSource(field reflect.StructField) (raw string, values []string, present bool) {
+    if name := field.Tag.Get("path"); name != "" {
+        if v, ok := r.pathParameters[name]; ok {
+            return v, []string{v}, true
+        }
+        return "", nil, false
+    }
+    if name := field.Tag.Get("query"); name != "" {
+        if vs, ok := r.Request.URL.Query()[name]; ok && len(vs) > 0 {
+            return vs[0], vs, true
+        }
+        return "", nil, false
+    }
+    if name := field.Tag.Get("header"); name != "" {
+        if v := r.Request.Header.Get(name); v != "" {
+            return v, []string{v}, true
+        }
+        return "", nil, false
+    }
+    if name := field.Tag.Get("form"); name != "" {
+        if err := r.Request.ParseForm(); err != nil {
+            return "", nil, false
+        }
+        if vs, ok := r.Request.PostForm[name]; ok && len(vs) > 0 {
+            return vs[0], vs, true
+        }
+        return "", nil, false
+    }
+    return "", nil, false
+}
+
+// setFieldValue coerces raw (or, for slices, values) into fieldValue
+// according to its Go type.
+func setFieldValue(fieldValue reflect.Value, raw string, values []string) error {
+    if fieldValue.Kind() == reflect.Ptr {
+        if fieldValue.IsNil() {
+            fieldValue.Set(reflect.New(fieldValue.Type().Elem()))
+        }
+        return setFieldValue(fieldValue.Elem(), raw, values)
+    }
+
+    if fieldValue.Type() == reflect.TypeOf(time.Time{}) {
+        t, err := time.Parse(time.RFC3339, raw)
+        if err != nil {
+            return err
+        }
+        fieldValue.Set(reflect.ValueOf(t))
+        return nil
+    }
+
+    switch fieldValue.Kind() {
+    case reflect.String:
+        fieldValue.SetString(raw)
+    case reflect.Bool:
+        b, err := strconv.ParseBool(raw)
+        if err != nil {
+            return err
+        }
+        fieldValue.SetBool(b)
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        n, err := strconv.ParseInt(raw, 10, 64)
+        if err != nil {
+            return err
+        }
+        fieldValue.SetInt(n)
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+        n, err := strconv.ParseUint(raw, 10, 64)
+        if err != nil {
+            return err
+        }
+        fieldValue.SetUint(n)
+    case reflect.Float32, reflect.Float64:
+        f, err := strconv.ParseFloat(raw, 64)
+        if err != nil {
+            return err
+        }
+        fieldValue.SetFloat(f)
+    case reflect.Slice:
+        elems := values
+        if len(elems) <= 1 {
+            elems = strings.Split(raw, ",")
+        }
+        slice := reflect.MakeSlice(fieldValue.Type(), len(elems), len(elems))
+        for i, elem := range elems {
+            if err := setFieldValue(slice.Index(i), elem, []string{elem}); err != nil {
+                return err
+            }
+        }
+        fieldValue.Set(slice)
+    default:
+        return errors.New("BindAll: unsupported field type " + fieldValue.Type().String())
+    }
+    return nil
+}
@@ -0,0 +1,144 @@
+package restful
+
+import (
+    "net/http"
+    "net/url"
+    "strings"
+    "testing"
+    "time"
+)
+
+type bindAllTarget struct {
+    ID        string    `path:"id"`
+    Limit     int       `query:"limit" default:"10"`
+    Tags      []string  `query:"tag"`
+    TraceID   string    `header:"X-Trace-Id"`
+    Name      string    `form:"name"`
+    Since     time.Time `query:"since"`
+    Nickname  *string   `query:"nickname"`
+    Body      string    `json:"-"`
+}
+
+func newBindAllRequest(t *testing.T, rawURL, body string) *Request {
+    t.Helper()
+    httpReq, err := http.NewRequest("POST", rawURL, strings.NewReader(body))
+    if err != nil {
+        t.Fatalf("failed to build request: %v", err)
+    }
+    httpReq.Header.Set(HEADER_ContentType, "application/x-www-form-urlencoded")
+    httpReq.Header.Set("X-Trace-Id", "trace-123")
+    req := NewRequest(httpReq)
+    req.pathParameters["id"] = "42"
+    return req
+}
+
+func TestBindAll(t *testing.T) {
+    req := newBindAllRequest(t, "http://example.com/items/42?limit=5&tag=a&tag=b&since=2021-01-02T15:04:05Z", "name=gopher")
+
+    var target bindAllTarget
+    if err := req.BindAll(&target); err != nil {
+        t.Fatalf("BindAll returned an error: %v", err)
+    }
+
+    if target.ID != "42" {
+        t.Errorf("expected ID 42, got %q", target.ID)
+    }
+    if target.Limit != 5 {
+        t.Errorf("expected Limit 5, got %d", target.Limit)
+    }
+    if len(target.Tags) != 2 || target.Tags[0] != "a" || target.Tags[1] != "b" {
+        t.Errorf("expected Tags [a b], got %v", target.Tags)
+    }
+    if target.TraceID != "trace-123" {
+        t.Errorf("expected TraceID trace-123, got %q", target.TraceID)
+    }
+    if target.Name != "gopher" {
+        t.Errorf("expected Name gopher, got %q", target.Name)
+    }
+    wantSince, _ := time.Parse(time.RFC3339, "2021-01-02T15:04:05Z")
+    if !target.Since.Equal(wantSince) {
+        t.Errorf("expected Since %v, got %v", wantSince, target.Since)
+    }
+    if target.Nickname != nil {
+        t.Errorf("expected Nickname to stay nil when absent, got %v", *target.Nickname)
+    }
+}
+
+func TestBindAll_default(t *testing.T) {
+    req := newBindAllRequest(t, "http://example.com/items/42", "")
+
+    var target bindAllTarget
+    if err := req.BindAll(&target); err != nil {
+        t.Fatalf("BindAll returned an error: %v", err)
+    }
+    if target.Limit != 10 {
+        t.Errorf("expected the default Limit 10 to apply, got %d", target.Limit)
+    }
+}
+
+func TestBindAll_optionalPointer(t *testing.T) {
+    req := newBindAllRequest(t, "http://example.com/items/42?nickname=gopherking", "")
+
+    var target bindAllTarget
+    if err := req.BindAll(&target); err != nil {
+        t.Fatalf("BindAll returned an error: %v", err)
+    }
+    if target.Nickname == nil || *target.Nickname != "gopherking" {
+        t.Errorf("expected Nickname to be populated, got %v", target.Nickname)
+    }
+}
+
+func TestBindAll_requiresStructPointer(t *testing.T) {
+    req := newBindAllRequest(t, "http://example.com/items/42", "")
+    var notAStruct string
+    if err := req.BindAll(&notAStruct); err == nil {
+        t.Error("expected an error when target is not a pointer to a struct")
+    }
+    if err := req.BindAll(bindAllTarget{}); err == nil {
+        t.Error("expected an error when target is not a pointer")
+    }
+}
+
+type bindAllRequiredTarget struct {
+    Name string `query:"name" binding:"required"`
+}
+
+func TestBindAll_requiredViolationReturnsFieldError(t *testing.T) {
+    req := newBindAllRequest(t, "http://example.com/items/42", "")
+
+    var target bindAllRequiredTarget
+    err := req.BindAll(&target)
+    if err == nil {
+        t.Fatal("expected an error when a required field is absent")
+    }
+    svcErr, ok := err.(ServiceError)
+    if !ok {
+        t.Fatalf("expected the same ServiceError shape ReadEntity produces via validationErrorHandler, got %T: %v", err, err)
+    }
+    if svcErr.Code != 400 {
+        t.Errorf("expected a 400 ServiceError, got %d", svcErr.Code)
+    }
+    if !strings.Contains(svcErr.Message, "required") {
+        t.Errorf("expected the message to mention the required constraint, got %q", svcErr.Message)
+    }
+}
+
+func TestBindAll_coercionFailure(t *testing.T) {
+    req := newBindAllRequest(t, "http://example.com/items/42?limit=notanumber", "")
+
+    var target bindAllTarget
+    if err := req.BindAll(&target); err == nil {
+        t.Error("expected an error when limit cannot be parsed as an int")
+    }
+}
+
+func TestBindAll_queryEscaping(t *testing.T) {
+    req := newBindAllRequest(t, "http://example.com/items/42?limit="+url.QueryEscape("7"), "")
+    var target bindAllTarget
+    if err := req.BindAll(&target); err != nil {
+        t.Fatalf("BindAll returned an error: %v", err)
+    }
+    if target.Limit != 7 {
+        t.Errorf("expected Limit 7, got %d", target.Limit)
+    }
+}
@@ -0,0 +1,111 @@
+package restful
+
+import (
+    "encoding/json"
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "testing"
+)
+
+func newStreamRequest(t *testing.T, body string) *Request {
+    t.Helper()
+    httpReq, err := http.NewRequest("POST", "http://example.com/items", strings.NewReader(body))
+    if err != nil {
+        t.Fatalf("failed to build request: %v", err)
+    }
+    httpReq.Header.Set(HEADER_ContentType, MIME_JSON)
+    return NewRequest(httpReq)
+}
+
+type streamItem struct {
+    Name string `json:"name"`
+}
+
+func TestReadEntityStream_decodesWithoutCaching(t *testing.T) {
+    req := newStreamRequest(t, `{"name":"gopher"}`)
+
+    var item streamItem
+    if err := req.ReadEntityStream(&item); err != nil {
+        t.Fatalf("ReadEntityStream returned an error: %v", err)
+    }
+    if item.Name != "gopher" {
+        t.Errorf("expected Name gopher, got %q", item.Name)
+    }
+    if req.bodyContent != nil {
+        t.Error("expected ReadEntityStream not to populate the bodyContent cache")
+    }
+}
+
+func TestDecodeJSONStream_invokesFnPerToken(t *testing.T) {
+    req := newStreamRequest(t, `[1,"two",3]`)
+
+    var tokens []json.Token
+    err := req.DecodeJSONStream(func(token json.Token) error {
+        tokens = append(tokens, token)
+        return nil
+    })
+    if err != nil {
+        t.Fatalf("DecodeJSONStream returned an error: %v", err)
+    }
+    if len(tokens) != 5 {
+        t.Fatalf("expected 5 tokens ([ 1 two 3 ]), got %d: %v", len(tokens), tokens)
+    }
+}
+
+func TestDecodeJSONStream_stopsOnFnError(t *testing.T) {
+    req := newStreamRequest(t, `[1,2,3]`)
+
+    wantErr := NewError(400, "stop")
+    count := 0
+    err := req.DecodeJSONStream(func(token json.Token) error {
+        count++
+        if count == 2 {
+            return wantErr
+        }
+        return nil
+    })
+    if err != wantErr {
+        t.Fatalf("expected DecodeJSONStream to stop on the callback's error, got %v", err)
+    }
+}
+
+func TestLimitRequestBody_tripsMaxBytesReaderOverLimit(t *testing.T) {
+    route := RouteBuilder{}
+    route.Method("POST").Path("/items").To(func(*Request, *Response) {}).MaxRequestBodyBytes(4)
+    r := route.Build()
+
+    httpReq := httptest.NewRequest("POST", "http://example.com/items", strings.NewReader(`{"name":"gopher"}`))
+    httpReq.Header.Set(HEADER_ContentType, MIME_JSON)
+    recorder := httptest.NewRecorder()
+
+    var item streamItem
+    r.Function = func(req *Request, resp *Response) {
+        err := req.ReadEntity(&item)
+        if err == nil {
+            t.Error("expected ReadEntity to fail once the body exceeds MaxRequestBodyBytes")
+        }
+    }
+    r.dispatch(recorder, httpReq)
+}
+
+func TestLimitRequestBody_unlimitedByDefault(t *testing.T) {
+    route := RouteBuilder{}
+    route.Method("POST").Path("/items").To(func(*Request, *Response) {})
+    r := route.Build()
+
+    httpReq := httptest.NewRequest("POST", "http://example.com/items", strings.NewReader(`{"name":"gopher"}`))
+    httpReq.Header.Set(HEADER_ContentType, MIME_JSON)
+    recorder := httptest.NewRecorder()
+
+    var item streamItem
+    r.Function = func(req *Request, resp *Response) {
+        if err := req.ReadEntity(&item); err != nil {
+            t.Errorf("ReadEntity returned an error: %v", err)
+        }
+    }
+    r.dispatch(recorder, httpReq)
+    if item.Name != "gopher" {
+        t.Errorf("expected Name gopher, got %q", item.Name)
+    }
+}
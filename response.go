@@ -0,0 +1,33 @@
+package restful
+
+// Copyright 2013 Ernest Micklei. All rights reserved.
+// Use of this source code is governed by a license
+// that can be found in the LICENSE file.
+
+import "net/http"
+
+// Response is a wrapper for a http ResponseWriter that provides convenience methods
+type Response struct {
+    http.ResponseWriter
+    requestAccept string // the value of the request's Accept header, used by WriteEntity
+}
+
+// NewResponse wraps httpWriter for use by a Route's RouteFunction.
+func NewResponse(httpWriter http.ResponseWriter) *Response {
+    return &Response{ResponseWriter: httpWriter}
+}
+
+// WriteEntity writes v to the response, picking an EntityReaderWriter by
+// negotiating against the request's Accept header (q-values honoured) the
+// same way Request.ReadEntity negotiates Content-Type. It falls back to
+// MIME_JSON when Accept is missing or matches no registered accessor.
+func (r *Response) WriteEntity(status int, v interface{}) error {
+    rw, _, ok := entityAccessors.accessorForAccept(r.requestAccept)
+    if !ok {
+        rw, ok = entityAccessors.accessorAt(MIME_JSON)
+    }
+    if !ok {
+        return NewError(500, "no entity accessor registered for "+MIME_JSON)
+    }
+    return rw.Write(r, status, v)
+}
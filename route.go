@@ -0,0 +1,33 @@
+package restful
+
+// Copyright 2013 Ernest Micklei. All rights reserved.
+// Use of this source code is governed by a license
+// that can be found in the LICENSE file.
+
+import "net/http"
+
+// RouteFunction declares the signature for a function that handles a service request.
+type RouteFunction func(*Request, *Response)
+
+// Route binds a HTTP Method,Path combination to a RouteFunction.
+type Route struct {
+    Method   string
+    Path     string
+    Function RouteFunction
+
+    // maxRequestBodyBytes is the limit configured via
+    // RouteBuilder.MaxRequestBodyBytes; 0 means unlimited.
+    maxRequestBodyBytes int64
+}
+
+// dispatch adapts a raw http.ResponseWriter/http.Request pair to the
+// Request/Response wrappers and invokes Function. It applies
+// maxRequestBodyBytes to the request body first, so that ReadEntity and
+// ReadEntityStream fail fast on an oversize payload instead of buffering it.
+func (r Route) dispatch(httpWriter http.ResponseWriter, httpRequest *http.Request) {
+    req := NewRequest(httpRequest)
+    limitRequestBody(httpWriter, req, r.maxRequestBodyBytes)
+    resp := NewResponse(httpWriter)
+    resp.requestAccept = httpRequest.Header.Get(HEADER_Accept)
+    r.Function(req, resp)
+}
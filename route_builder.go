@@ -0,0 +1,43 @@
+package restful
+
+// Copyright 2013 Ernest Micklei. All rights reserved.
+// Use of this source code is governed by a license
+// that can be found in the LICENSE file.
+
+// RouteBuilder is used to build Route, typically via WebService.Route(..).
+type RouteBuilder struct {
+    rootPath    string
+    currentPath string
+    httpMethod  string
+    function    RouteFunction
+
+    maxRequestBodyBytes int64
+}
+
+// Method specifies what HTTP method to match. Required.
+func (b *RouteBuilder) Method(method string) *RouteBuilder {
+    b.httpMethod = method
+    return b
+}
+
+// Path specifies the relative (w.r.t WebService root path) path of the Route. Required.
+func (b *RouteBuilder) Path(subPath string) *RouteBuilder {
+    b.currentPath = subPath
+    return b
+}
+
+// To bind the route to a function. Required.
+func (b *RouteBuilder) To(function RouteFunction) *RouteBuilder {
+    b.function = function
+    return b
+}
+
+// Build creates a new Route using the current RouteBuilder values.
+func (b *RouteBuilder) Build() Route {
+    return Route{
+        Method:              b.httpMethod,
+        Path:                b.rootPath + b.currentPath,
+        Function:            b.function,
+        maxRequestBodyBytes: b.maxRequestBodyBytes,
+    }
+}
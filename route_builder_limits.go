@@ -0,0 +1,24 @@
+package restful
+
+import "net/http"
+
+// MaxRequestBodyBytes limits the size of the request body read by ReadEntity,
+// ReadEntityStream and DecodeJSONStream for routes built from this
+// RouteBuilder, by wrapping the request Body in a http.MaxBytesReader. A
+// limit of 0 (the default) leaves the body unlimited. Exceeding the limit
+// causes the decoder to fail fast instead of reading an arbitrarily large
+// payload into memory.
+func (b *RouteBuilder) MaxRequestBodyBytes(n int64) *RouteBuilder {
+    b.maxRequestBodyBytes = n
+    return b
+}
+
+// limitRequestBody wraps req's Body in a http.MaxBytesReader when a
+// maxRequestBodyBytes limit was configured on the route. Called from
+// Route.dispatch before the RouteFunction runs.
+func limitRequestBody(w http.ResponseWriter, req *Request, maxBytes int64) {
+    if maxBytes <= 0 {
+        return
+    }
+    req.Request.Body = http.MaxBytesReader(w, req.Request.Body, maxBytes)
+}
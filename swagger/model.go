@@ -0,0 +1,42 @@
+package swagger
+
+// Copyright 2015 Ernest Micklei. All rights reserved.
+// Use of this source code is governed by a license
+// that can be found in the LICENSE file.
+
+// Model is the Swagger 1.2 representation of a (Go) struct type.
+type Model struct {
+	Id         string                   `json:"id"`
+	Required   []string                 `json:"required,omitempty"`
+	Properties map[string]ModelProperty `json:"properties"`
+}
+
+// Item describes the element type of an "array" ModelProperty.
+type Item struct {
+	Type *string `json:"type,omitempty"`
+	Ref  *string `json:"$ref,omitempty"`
+}
+
+// ModelProperty is one field of a Model.
+type ModelProperty struct {
+	Type        *string  `json:"type,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Items       *Item    `json:"items,omitempty"`
+	Format      string   `json:"format,omitempty"`
+	Ref         *string  `json:"$ref,omitempty"`
+	Default     string   `json:"default,omitempty"`
+	Enum        []string `json:"enum,omitempty"`
+	Minimum     string   `json:"minimum,omitempty"`
+	Maximum     string   `json:"maximum,omitempty"`
+
+	// Tag vocabulary used by modern OpenAPI generators. These fields are not
+	// part of the original Swagger 1.2 spec; they are marshaled alongside the
+	// Swagger 1.2 fields whenever they are set.
+	Example    string `json:"example,omitempty"`
+	MinLength  string `json:"minLength,omitempty"`
+	MaxLength  string `json:"maxLength,omitempty"`
+	Pattern    string `json:"pattern,omitempty"`
+	ReadOnly   bool   `json:"readOnly,omitempty"`
+	WriteOnly  bool   `json:"writeOnly,omitempty"`
+	Deprecated bool   `json:"deprecated,omitempty"`
+}
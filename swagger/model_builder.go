@@ -60,10 +60,15 @@ func (b modelBuilder) addModel(st reflect.Type, nameOverride string) *Model {
 	}
 	for i := 0; i < st.NumField(); i++ {
 		field := st.Field(i)
+		// swagger:"-" skips the field from the schema even when json marshals it
+		if field.Tag.Get("swagger") == "-" {
+			continue
+		}
 		jsonName, prop := b.buildProperty(field, &sm, modelName)
 		if descTag := field.Tag.Get("description"); descTag != "" {
 			prop.Description = descTag
 		}
+		b.applyPropertyTags(field, &prop)
 		// add if not ommitted
 		if len(jsonName) != 0 {
 			// update Required
@@ -153,6 +158,56 @@ func (b modelBuilder) buildProperty(field reflect.StructField, model *Model, mod
 	return jsonName, prop
 }
 
+// applyPropertyTags populates the ModelProperty fields used by modern
+// OpenAPI generators from a wider tag vocabulary than "description" alone:
+// example, enum, minimum/maximum, minLength/maxLength, pattern, default,
+// readOnly, writeOnly and deprecated.
+func (b modelBuilder) applyPropertyTags(field reflect.StructField, prop *ModelProperty) {
+	if example := field.Tag.Get("example"); example != "" {
+		prop.Example = example
+	}
+	if enum := field.Tag.Get("enum"); enum != "" {
+		prop.Enum = strings.Split(enum, ",")
+	}
+	if min := field.Tag.Get("minimum"); min != "" {
+		prop.Minimum = min
+	}
+	if max := field.Tag.Get("maximum"); max != "" {
+		prop.Maximum = max
+	}
+	if minLength := field.Tag.Get("minLength"); minLength != "" {
+		prop.MinLength = minLength
+	}
+	if maxLength := field.Tag.Get("maxLength"); maxLength != "" {
+		prop.MaxLength = maxLength
+	}
+	if pattern := field.Tag.Get("pattern"); pattern != "" {
+		prop.Pattern = pattern
+	}
+	if def := field.Tag.Get("default"); def != "" {
+		prop.Default = def
+	}
+	if field.Tag.Get("readOnly") == "true" {
+		prop.ReadOnly = true
+	}
+	if field.Tag.Get("writeOnly") == "true" {
+		prop.WriteOnly = true
+	}
+	if field.Tag.Get("deprecated") == "true" {
+		prop.Deprecated = true
+	}
+}
+
+// swaggerRefOverride returns the $ref target name requested via
+// `swagger:"ref=MyAlias"`, or "" if the field does not override it.
+func swaggerRefOverride(field reflect.StructField) string {
+	swaggerTag := field.Tag.Get("swagger")
+	if strings.HasPrefix(swaggerTag, "ref=") {
+		return strings.TrimPrefix(swaggerTag, "ref=")
+	}
+	return ""
+}
+
 func hasNamedJSONTag(field reflect.StructField) bool {
 	parts := strings.Split(field.Tag.Get("json"), ",")
 	if len(parts) == 0 {
@@ -206,8 +261,11 @@ func (b modelBuilder) buildStructTypeProperty(field reflect.StructField, jsonNam
 		return "", prop
 	}
 	// simple struct
-	b.addModel(fieldType, "")
 	var pType = fieldType.String()
+	if alias := swaggerRefOverride(field); alias != "" {
+		pType = alias
+	}
+	b.addModel(fieldType, pType)
 	prop.Ref = &pType
 	return jsonName, prop
 }
@@ -222,6 +280,9 @@ func (b modelBuilder) buildArrayTypeProperty(field reflect.StructField, jsonName
 		mapped := b.jsonSchemaType(elemTypeName)
 		prop.Items.Type = &mapped
 	} else {
+		if alias := swaggerRefOverride(field); alias != "" {
+			elemTypeName = alias
+		}
 		prop.Items.Ref = &elemTypeName
 	}
 	// add|overwrite model for element type
@@ -240,6 +301,9 @@ func (b modelBuilder) buildPointerTypeProperty(field reflect.StructField, jsonNa
 		var pType = "array"
 		prop.Type = &pType
 		elemName := b.getElementTypeName(modelName, jsonName, fieldType.Elem().Elem())
+		if alias := swaggerRefOverride(field); alias != "" {
+			elemName = alias
+		}
 		prop.Items = &Item{Ref: &elemName}
 		// add|overwrite model for element type
 		b.addModel(fieldType.Elem().Elem(), elemName)
@@ -250,6 +314,11 @@ func (b modelBuilder) buildPointerTypeProperty(field reflect.StructField, jsonNa
 		elemName := ""
 		if fieldType.Elem().Name() == "" {
 			elemName = modelName + "." + jsonName
+		}
+		if alias := swaggerRefOverride(field); alias != "" {
+			elemName = alias
+		}
+		if elemName != "" {
 			prop.Ref = &elemName
 		}
 		b.addModel(fieldType.Elem(), elemName)
@@ -0,0 +1,74 @@
+package swagger
+
+import (
+	"reflect"
+	"testing"
+)
+
+type tagVocabSample struct {
+	Name     string `json:"name" example:"Ernest" minLength:"1" maxLength:"50" pattern:"^[A-Za-z ]+$"`
+	Status   string `json:"status" enum:"open,closed" default:"open" readOnly:"true"`
+	Password string `json:"password" writeOnly:"true"`
+	Legacy   string `json:"legacy" deprecated:"true"`
+	Secret   string `json:"secret" swagger:"-"`
+}
+
+func TestModelBuilder_tagVocabulary(t *testing.T) {
+	b := modelBuilder{Models: map[string]Model{}}
+	b.addModel(reflect.TypeOf(tagVocabSample{}), "")
+	model, ok := b.Models["swagger.tagVocabSample"]
+	if !ok {
+		t.Fatalf("expected tagVocabSample to be registered, got %v", b.Models)
+	}
+
+	if _, present := model.Properties["secret"]; present {
+		t.Errorf("expected swagger:\"-\" to skip the secret field entirely")
+	}
+
+	name := model.Properties["name"]
+	if name.Example != "Ernest" || name.MinLength != "1" || name.MaxLength != "50" || name.Pattern != "^[A-Za-z ]+$" {
+		t.Errorf("expected example/minLength/maxLength/pattern tags to populate name, got %+v", name)
+	}
+
+	status := model.Properties["status"]
+	if len(status.Enum) != 2 || status.Enum[0] != "open" || status.Default != "open" || !status.ReadOnly {
+		t.Errorf("expected enum/default/readOnly tags to populate status, got %+v", status)
+	}
+
+	if !model.Properties["password"].WriteOnly {
+		t.Errorf("expected writeOnly tag to set WriteOnly on password")
+	}
+	if !model.Properties["legacy"].Deprecated {
+		t.Errorf("expected deprecated tag to set Deprecated on legacy")
+	}
+}
+
+type refOverrideInner struct {
+	Name string `json:"name"`
+}
+
+type refOverrideSample struct {
+	Single refOverrideInner   `json:"single" swagger:"ref=CustomInner"`
+	Many   []refOverrideInner `json:"many" swagger:"ref=CustomInner"`
+	Maybe  *refOverrideInner  `json:"maybe,omitempty" swagger:"ref=CustomInner"`
+}
+
+func TestModelBuilder_refOverride(t *testing.T) {
+	b := modelBuilder{Models: map[string]Model{}}
+	b.addModel(reflect.TypeOf(refOverrideSample{}), "")
+	model := b.Models["swagger.refOverrideSample"]
+
+	if ref := model.Properties["single"].Ref; ref == nil || *ref != "CustomInner" {
+		t.Errorf("expected struct field $ref override to be CustomInner, got %v", ref)
+	}
+	many := model.Properties["many"]
+	if many.Items == nil || many.Items.Ref == nil || *many.Items.Ref != "CustomInner" {
+		t.Errorf("expected slice element $ref override to be CustomInner, got %+v", many)
+	}
+	if ref := model.Properties["maybe"].Ref; ref == nil || *ref != "CustomInner" {
+		t.Errorf("expected pointer field $ref override to be CustomInner, got %v", ref)
+	}
+	if _, ok := b.Models["CustomInner"]; !ok {
+		t.Errorf("expected CustomInner to be registered under the overridden name")
+	}
+}
@@ -0,0 +1,319 @@
+package restful
+
+// Copyright 2013 Ernest Micklei. All rights reserved.
+// Use of this source code is governed by a license
+// that can be found in the LICENSE file.
+
+import (
+    "fmt"
+    "reflect"
+    "regexp"
+    "strconv"
+    "strings"
+)
+
+// FieldError describes a single failed `validate` constraint.
+type FieldError struct {
+    Field string      // struct field name, e.g. "Zip"
+    Tag   string      // the constraint that failed, e.g. "min"
+    Param string      // the constraint's parameter, e.g. "1" for min=1
+    Value interface{} // the offending value
+    Path  string      // dotted path from the root value, e.g. "Address.Zip"
+}
+
+func (e FieldError) Error() string {
+    return fmt.Sprintf("validation failed on '%s' for field '%s'", e.Tag, e.Path)
+}
+
+// ValidationErrors collects every FieldError found while validating a value.
+// It implements error so it can be returned as-is, but handlers typically
+// want the individual FieldErrors to build a field-level 400 response.
+type ValidationErrors []FieldError
+
+func (e ValidationErrors) Error() string {
+    msgs := make([]string, len(e))
+    for i, fe := range e {
+        msgs[i] = fe.Error()
+    }
+    return strings.Join(msgs, "; ")
+}
+
+// ValidationErrorHandler turns ValidationErrors produced while decoding a
+// request entity into the error returned from ReadEntity. The default wraps
+// them in a 400 ServiceError; replace it with SetValidationErrorHandler to
+// emit a custom field-level error response.
+type ValidationErrorHandler func(errs ValidationErrors) error
+
+var validationErrorHandler ValidationErrorHandler = func(errs ValidationErrors) error {
+    return NewError(400, errs.Error())
+}
+
+// SetValidationErrorHandler replaces the handler used to turn ValidationErrors
+// into the error returned from ReadEntity/ReadEntityStream.
+func SetValidationErrorHandler(handler ValidationErrorHandler) {
+    validationErrorHandler = handler
+}
+
+// validatorFunc implements a single named `validate` constraint, e.g. "min"
+// or "email". param holds the text following "=" in the tag, if any.
+type validatorFunc func(value reflect.Value, param string) error
+
+var validators = map[string]validatorFunc{
+    "min":    validateMin,
+    "max":    validateMax,
+    "email":  validateEmail,
+    "uuid":   validateUUID,
+    "oneof":  validateOneof,
+    "regexp": validateRegexp,
+}
+
+// RegisterValidator adds or replaces the validatorFunc used for the named
+// `validate` tag constraint, e.g. RegisterValidator("iso3166", fn).
+func RegisterValidator(tag string, fn func(value reflect.Value, param string) error) {
+    validators[tag] = fn
+}
+
+var (
+    emailPattern = regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`)
+    uuidPattern  = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+func validateMin(value reflect.Value, param string) error {
+    n, err := strconv.ParseFloat(param, 64)
+    if err != nil {
+        return err
+    }
+    if length, ok := lengthOf(value); ok {
+        if float64(length) < n {
+            return fmt.Errorf("length must be at least %s", param)
+        }
+        return nil
+    }
+    if numericLess(value, n) {
+        return fmt.Errorf("must be at least %s", param)
+    }
+    return nil
+}
+
+func validateMax(value reflect.Value, param string) error {
+    n, err := strconv.ParseFloat(param, 64)
+    if err != nil {
+        return err
+    }
+    if length, ok := lengthOf(value); ok {
+        if float64(length) > n {
+            return fmt.Errorf("length must be at most %s", param)
+        }
+        return nil
+    }
+    if numericGreater(value, n) {
+        return fmt.Errorf("must be at most %s", param)
+    }
+    return nil
+}
+
+func lengthOf(value reflect.Value) (int, bool) {
+    switch value.Kind() {
+    case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+        return value.Len(), true
+    }
+    return 0, false
+}
+
+func numericLess(value reflect.Value, n float64) bool {
+    switch value.Kind() {
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        return float64(value.Int()) < n
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+        return float64(value.Uint()) < n
+    case reflect.Float32, reflect.Float64:
+        return value.Float() < n
+    }
+    return false
+}
+
+func numericGreater(value reflect.Value, n float64) bool {
+    switch value.Kind() {
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        return float64(value.Int()) > n
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+        return float64(value.Uint()) > n
+    case reflect.Float32, reflect.Float64:
+        return value.Float() > n
+    }
+    return false
+}
+
+func validateEmail(value reflect.Value, param string) error {
+    if value.Kind() != reflect.String {
+        return fmt.Errorf("email applies to string fields only")
+    }
+    if !emailPattern.MatchString(value.String()) {
+        return fmt.Errorf("must be a valid email address")
+    }
+    return nil
+}
+
+func validateUUID(value reflect.Value, param string) error {
+    if value.Kind() != reflect.String {
+        return fmt.Errorf("uuid applies to string fields only")
+    }
+    if !uuidPattern.MatchString(value.String()) {
+        return fmt.Errorf("must be a valid uuid")
+    }
+    return nil
+}
+
+func validateOneof(value reflect.Value, param string) error {
+    if value.Kind() != reflect.String {
+        return fmt.Errorf("oneof applies to string fields only")
+    }
+    for _, allowed := range strings.Fields(param) {
+        if value.String() == allowed {
+            return nil
+        }
+    }
+    return fmt.Errorf("must be one of [%s]", param)
+}
+
+func validateRegexp(value reflect.Value, param string) error {
+    if value.Kind() != reflect.String {
+        return fmt.Errorf("regexp applies to string fields only")
+    }
+    pattern, err := regexp.Compile(param)
+    if err != nil {
+        return err
+    }
+    if !pattern.MatchString(value.String()) {
+        return fmt.Errorf("must match pattern %s", param)
+    }
+    return nil
+}
+
+// ValidateStruct walks obj (a struct or pointer to struct) applying every
+// `validate` tag constraint, descending into nested structs, slices and
+// maps. It returns one FieldError per violated constraint.
+func ValidateStruct(obj interface{}) ValidationErrors {
+    var errs ValidationErrors
+    validateValue(reflect.ValueOf(obj), "", &errs)
+    return errs
+}
+
+func validateValue(val reflect.Value, path string, errs *ValidationErrors) {
+    for val.Kind() == reflect.Ptr {
+        if val.IsNil() {
+            return
+        }
+        val = val.Elem()
+    }
+
+    switch val.Kind() {
+    case reflect.Struct:
+        validateStructValue(val, path, errs)
+    case reflect.Slice, reflect.Array:
+        for i := 0; i < val.Len(); i++ {
+            validateValue(val.Index(i), fmt.Sprintf("%s[%d]", path, i), errs)
+        }
+    case reflect.Map:
+        for _, key := range val.MapKeys() {
+            validateValue(val.MapIndex(key), fmt.Sprintf("%s[%v]", path, key.Interface()), errs)
+        }
+    }
+}
+
+func validateStructValue(val reflect.Value, path string, errs *ValidationErrors) {
+    typ := val.Type()
+    for i := 0; i < typ.NumField(); i++ {
+        field := typ.Field(i)
+        fieldValue := val.Field(i)
+        fieldPath := field.Name
+        if path != "" {
+            fieldPath = path + "." + field.Name
+        }
+
+        tag := field.Tag.Get("validate")
+        if tag != "" {
+            validateField(fieldValue, field, tag, fieldPath, val, errs)
+        }
+        // The legacy `binding:"required"` tag (see Validate) is honoured here
+        // too, so a required field fails through the same ValidationErrors/
+        // ValidationErrorHandler path regardless of which tag declared it.
+        if strings.Contains(field.Tag.Get("binding"), "required") && isZero(fieldValue) {
+            appendFieldError(errs, field, "required", "", fieldPath, fieldValue)
+        }
+
+        switch fieldValue.Kind() {
+        case reflect.Struct, reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+            validateValue(fieldValue, fieldPath, errs)
+        }
+    }
+}
+
+func validateField(fieldValue reflect.Value, field reflect.StructField, tag, path string, parent reflect.Value, errs *ValidationErrors) {
+    for _, constraint := range strings.Split(tag, ",") {
+        name, param := constraint, ""
+        if idx := strings.Index(constraint, "="); idx >= 0 {
+            name, param = constraint[:idx], constraint[idx+1:]
+        }
+
+        switch name {
+        case "required":
+            if isZero(fieldValue) {
+                appendFieldError(errs, field, name, param, path, fieldValue)
+            }
+            continue
+        case "required_if":
+            parts := strings.Fields(param)
+            if len(parts) == 2 && fieldEquals(parent, parts[0], parts[1]) && isZero(fieldValue) {
+                appendFieldError(errs, field, name, param, path, fieldValue)
+            }
+            continue
+        case "required_without":
+            if isZero(fieldByName(parent, param)) && isZero(fieldValue) {
+                appendFieldError(errs, field, name, param, path, fieldValue)
+            }
+            continue
+        }
+
+        fn, ok := validators[name]
+        if !ok {
+            continue
+        }
+        if err := fn(fieldValue, param); err != nil {
+            appendFieldError(errs, field, name, param, path, fieldValue)
+        }
+    }
+}
+
+func appendFieldError(errs *ValidationErrors, field reflect.StructField, tag, param, path string, value reflect.Value) {
+    var v interface{}
+    if value.IsValid() && value.CanInterface() {
+        v = value.Interface()
+    }
+    *errs = append(*errs, FieldError{Field: field.Name, Tag: tag, Param: param, Value: v, Path: path})
+}
+
+func fieldByName(structValue reflect.Value, name string) reflect.Value {
+    for structValue.Kind() == reflect.Ptr {
+        structValue = structValue.Elem()
+    }
+    if structValue.Kind() != reflect.Struct {
+        return reflect.Value{}
+    }
+    return structValue.FieldByName(name)
+}
+
+func fieldEquals(structValue reflect.Value, name, want string) bool {
+    fv := fieldByName(structValue, name)
+    if !fv.IsValid() {
+        return false
+    }
+    return fmt.Sprintf("%v", fv.Interface()) == want
+}
+
+func isZero(value reflect.Value) bool {
+    if !value.IsValid() {
+        return true
+    }
+    return reflect.DeepEqual(value.Interface(), reflect.Zero(value.Type()).Interface())
+}
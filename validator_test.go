@@ -0,0 +1,154 @@
+package restful
+
+import (
+    "errors"
+    "reflect"
+    "testing"
+)
+
+var errOdd = errors.New("must be even")
+
+type testAddress struct {
+    Zip string `validate:"required,regexp=^[0-9]{5}$"`
+}
+
+type testPerson struct {
+    Name    string   `validate:"required,min=2,max=10"`
+    Email   string   `validate:"email"`
+    Role    string   `validate:"oneof=admin user"`
+    Age     int      `validate:"min=0,max=130"`
+    Tags    []string `validate:"min=1"`
+    Address testAddress
+}
+
+func TestValidateStruct_valid(t *testing.T) {
+    p := testPerson{
+        Name:    "Ernest",
+        Email:   "ernest@example.com",
+        Role:    "admin",
+        Age:     30,
+        Tags:    []string{"go"},
+        Address: testAddress{Zip: "12345"},
+    }
+    if errs := ValidateStruct(&p); len(errs) != 0 {
+        t.Fatalf("expected no errors, got %v", errs)
+    }
+}
+
+func TestValidateStruct_required(t *testing.T) {
+    p := testPerson{}
+    errs := ValidateStruct(&p)
+    if !hasFieldError(errs, "Name", "required") {
+        t.Errorf("expected a required error on Name, got %v", errs)
+    }
+}
+
+func TestValidateStruct_constraints(t *testing.T) {
+    p := testPerson{
+        Name:    "E",
+        Email:   "not-an-email",
+        Role:    "superuser",
+        Age:     200,
+        Tags:    []string{"go"},
+        Address: testAddress{Zip: "abc"},
+    }
+    errs := ValidateStruct(&p)
+    for _, expect := range []struct {
+        field, tag string
+    }{
+        {"Name", "min"},
+        {"Email", "email"},
+        {"Role", "oneof"},
+        {"Age", "max"},
+        {"Zip", "regexp"},
+    } {
+        if !hasFieldError(errs, expect.field, expect.tag) {
+            t.Errorf("expected a %s error on %s, got %v", expect.tag, expect.field, errs)
+        }
+    }
+}
+
+func TestValidateStruct_constraintsOnZeroValue(t *testing.T) {
+    type thing struct {
+        Tags []string `validate:"min=1"`
+        Role string   `validate:"oneof=admin user"`
+    }
+    errs := ValidateStruct(&thing{})
+    if !hasFieldError(errs, "Tags", "min") {
+        t.Errorf("expected a min error on a nil Tags slice, got %v", errs)
+    }
+    if !hasFieldError(errs, "Role", "oneof") {
+        t.Errorf("expected an oneof error on an empty Role, got %v", errs)
+    }
+}
+
+func TestValidateStruct_requiredIf(t *testing.T) {
+    type shipment struct {
+        Method      string `validate:"oneof=pickup courier"`
+        CourierName string `validate:"required_if=Method courier"`
+    }
+    s := shipment{Method: "courier"}
+    errs := ValidateStruct(&s)
+    if !hasFieldError(errs, "CourierName", "required_if") {
+        t.Errorf("expected a required_if error on CourierName, got %v", errs)
+    }
+}
+
+func TestValidateStruct_requiredWithout(t *testing.T) {
+    type contact struct {
+        Email string `validate:"required_without=Phone"`
+        Phone string `validate:"required_without=Email"`
+    }
+
+    errs := ValidateStruct(&contact{})
+    if !hasFieldError(errs, "Email", "required_without") {
+        t.Errorf("expected a required_without error on Email when both are absent, got %v", errs)
+    }
+    if !hasFieldError(errs, "Phone", "required_without") {
+        t.Errorf("expected a required_without error on Phone when both are absent, got %v", errs)
+    }
+
+    errs = ValidateStruct(&contact{Email: "e@example.com"})
+    if hasFieldError(errs, "Phone", "required_without") {
+        t.Errorf("expected no required_without error on Phone once Email is present, got %v", errs)
+    }
+}
+
+func TestValidateStruct_bindingRequired(t *testing.T) {
+    type legacy struct {
+        Name string `json:"name" binding:"required"`
+    }
+    errs := ValidateStruct(&legacy{})
+    if !hasFieldError(errs, "Name", "required") {
+        t.Errorf("expected binding:\"required\" to surface as a FieldError, got %v", errs)
+    }
+}
+
+func TestRegisterValidator(t *testing.T) {
+    RegisterValidator("even", func(value reflect.Value, param string) error {
+        if value.Int()%2 != 0 {
+            return errOdd
+        }
+        return nil
+    })
+    defer delete(validators, "even")
+
+    type numbers struct {
+        N int `validate:"even"`
+    }
+    if errs := ValidateStruct(&numbers{N: 3}); !hasFieldError(errs, "N", "even") {
+        t.Errorf("expected a custom 'even' error, got %v", errs)
+    }
+    if errs := ValidateStruct(&numbers{N: 4}); len(errs) != 0 {
+        t.Errorf("expected no errors for an even value, got %v", errs)
+    }
+}
+
+func hasFieldError(errs ValidationErrors, field, tag string) bool {
+    for _, e := range errs {
+        if e.Field == field && e.Tag == tag {
+            return true
+        }
+    }
+    return false
+}
@@ -0,0 +1,41 @@
+package restful
+
+// Copyright 2013 Ernest Micklei. All rights reserved.
+// Use of this source code is governed by a license
+// that can be found in the LICENSE file.
+
+// WebService holds a collection of Route for a set of related resources.
+type WebService struct {
+    rootPath string
+    produces []string
+    routes   []Route
+}
+
+// Path specifies the root URL template path of the WebService. All Routes will be relative to this path.
+func (w *WebService) Path(root string) *WebService {
+    w.rootPath = root
+    return w
+}
+
+// Produces specifies the MIME types that this WebService can produce.
+func (w *WebService) Produces(contentTypes ...string) *WebService {
+    w.produces = contentTypes
+    return w
+}
+
+// Route adds a Route built with a RouteBuilder to the WebService.
+func (w *WebService) Route(builder *RouteBuilder) *WebService {
+    builder.rootPath = w.rootPath
+    w.routes = append(w.routes, builder.Build())
+    return w
+}
+
+// GET is shorthand for .Method("GET").Path(subPath)
+func (w *WebService) GET(subPath string) *RouteBuilder {
+    return new(RouteBuilder).Method("GET").Path(subPath)
+}
+
+// POST is shorthand for .Method("POST").Path(subPath)
+func (w *WebService) POST(subPath string) *RouteBuilder {
+    return new(RouteBuilder).Method("POST").Path(subPath)
+}
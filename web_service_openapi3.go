@@ -0,0 +1,15 @@
+package restful
+
+// MIME_OPENAPI3_JSON is the media type of an OpenAPI 3.0 document in JSON.
+// The /openapi.json endpoint of openapi3.NewOpenAPI3Service writes through
+// Response.WriteEntity, so its Content-Type is the negotiated one (by
+// default MIME_JSON), not this value.
+const MIME_OPENAPI3_JSON = "application/vnd.oai.openapi+json"
+
+// ProducesOpenAPI3 is a convenience for Produces(MIME_OPENAPI3_JSON) so that
+// services migrating from the Swagger 1.2 declaration can advertise an
+// OpenAPI 3.0 representation. Like Produces, it replaces any MIME types set
+// earlier on the WebService.
+func (w *WebService) ProducesOpenAPI3() *WebService {
+    return w.Produces(MIME_OPENAPI3_JSON)
+}